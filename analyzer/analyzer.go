@@ -0,0 +1,98 @@
+// Package analyzer computes descriptive statistics over a slice of numbers
+// in-process, so callers aren't forced to depend on a remote endpoint for
+// basic analysis.
+package analyzer
+
+import (
+    "math"
+    "sort"
+)
+
+// defaultPercentiles are used when Options.Percentiles is empty.
+var defaultPercentiles = []float64{50, 90, 99}
+
+// Options configures Analyze.
+type Options struct {
+    // Percentiles lists the percentiles (0-100) to compute, in addition to
+    // the fixed statistics. Defaults to p50/p90/p99 when empty.
+    Percentiles []float64
+}
+
+// Result holds the statistics computed by Analyze.
+type Result struct {
+    Mean        float64
+    Median      float64
+    StdDev      float64
+    Variance    float64
+    Min         float64
+    Max         float64
+    Sum         float64
+    Count       int
+    Percentiles map[float64]float64
+}
+
+// Analyze computes mean, median, standard deviation, variance, min, max,
+// sum, count, and the requested percentiles over nums.
+func Analyze(nums []float64, opts Options) Result {
+    if len(nums) == 0 {
+        return Result{Percentiles: map[float64]float64{}}
+    }
+
+    sorted := make([]float64, len(nums))
+    copy(sorted, nums)
+    sort.Float64s(sorted)
+
+    sum := 0.0
+    for _, n := range sorted {
+        sum += n
+    }
+    mean := sum / float64(len(sorted))
+
+    variance := 0.0
+    for _, n := range sorted {
+        d := n - mean
+        variance += d * d
+    }
+    variance /= float64(len(sorted))
+
+    percentiles := opts.Percentiles
+    if len(percentiles) == 0 {
+        percentiles = defaultPercentiles
+    }
+    computed := make(map[float64]float64, len(percentiles))
+    for _, p := range percentiles {
+        computed[p] = percentile(sorted, p)
+    }
+
+    return Result{
+        Mean:        mean,
+        Median:      percentile(sorted, 50),
+        StdDev:      math.Sqrt(variance),
+        Variance:    variance,
+        Min:         sorted[0],
+        Max:         sorted[len(sorted)-1],
+        Sum:         sum,
+        Count:       len(sorted),
+        Percentiles: computed,
+    }
+}
+
+// percentile returns the pth percentile (0-100) of pre-sorted data, linearly
+// interpolating between the two closest ranks.
+func percentile(sorted []float64, p float64) float64 {
+    if len(sorted) == 1 || p <= 0 {
+        return sorted[0]
+    }
+    if p >= 100 {
+        return sorted[len(sorted)-1]
+    }
+
+    rank := (p / 100) * float64(len(sorted)-1)
+    lower := int(math.Floor(rank))
+    upper := int(math.Ceil(rank))
+    if lower == upper {
+        return sorted[lower]
+    }
+    frac := rank - float64(lower)
+    return sorted[lower]*(1-frac) + sorted[upper]*frac
+}
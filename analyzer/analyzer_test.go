@@ -0,0 +1,68 @@
+package analyzer
+
+import (
+    "math"
+    "testing"
+)
+
+func almostEqual(a, b float64) bool {
+    return math.Abs(a-b) < 1e-9
+}
+
+func TestAnalyzeBasicStats(t *testing.T) {
+    result := Analyze([]float64{1, 2, 3, 4, 5}, Options{})
+
+    if !almostEqual(result.Mean, 3) {
+        t.Errorf("Mean = %v, want 3", result.Mean)
+    }
+    if !almostEqual(result.Median, 3) {
+        t.Errorf("Median = %v, want 3", result.Median)
+    }
+    if !almostEqual(result.Min, 1) {
+        t.Errorf("Min = %v, want 1", result.Min)
+    }
+    if !almostEqual(result.Max, 5) {
+        t.Errorf("Max = %v, want 5", result.Max)
+    }
+    if !almostEqual(result.Sum, 15) {
+        t.Errorf("Sum = %v, want 15", result.Sum)
+    }
+    if result.Count != 5 {
+        t.Errorf("Count = %v, want 5", result.Count)
+    }
+    wantVariance := 2.0
+    if !almostEqual(result.Variance, wantVariance) {
+        t.Errorf("Variance = %v, want %v", result.Variance, wantVariance)
+    }
+    if !almostEqual(result.StdDev, math.Sqrt(wantVariance)) {
+        t.Errorf("StdDev = %v, want %v", result.StdDev, math.Sqrt(wantVariance))
+    }
+}
+
+func TestAnalyzeDefaultPercentiles(t *testing.T) {
+    result := Analyze([]float64{1, 2, 3, 4, 5}, Options{})
+
+    for _, p := range []float64{50, 90, 99} {
+        if _, ok := result.Percentiles[p]; !ok {
+            t.Errorf("missing default percentile %v", p)
+        }
+    }
+}
+
+func TestAnalyzeCustomPercentiles(t *testing.T) {
+    result := Analyze([]float64{1, 2, 3, 4, 5}, Options{Percentiles: []float64{0, 100}})
+
+    if !almostEqual(result.Percentiles[0], 1) {
+        t.Errorf("p0 = %v, want 1", result.Percentiles[0])
+    }
+    if !almostEqual(result.Percentiles[100], 5) {
+        t.Errorf("p100 = %v, want 5", result.Percentiles[100])
+    }
+}
+
+func TestAnalyzeEmptyInput(t *testing.T) {
+    result := Analyze(nil, Options{})
+    if len(result.Percentiles) != 0 {
+        t.Errorf("expected no percentiles for empty input, got %v", result.Percentiles)
+    }
+}
@@ -0,0 +1,157 @@
+package main
+
+import (
+    "bufio"
+    "bytes"
+    "encoding/json"
+    "fmt"
+    "io/ioutil"
+    "net/http"
+    "strings"
+    "sync"
+    "time"
+)
+
+// Message is a single role-tagged turn in a chat conversation.
+type Message struct {
+    Role    string `json:"role"`
+    Content string `json:"content"`
+}
+
+type chatCompletionRequest struct {
+    Messages []Message `json:"messages"`
+    Stream   bool      `json:"stream"`
+}
+
+type streamChunk struct {
+    Choices []struct {
+        Delta struct {
+            Content string `json:"content"`
+        } `json:"delta"`
+    } `json:"choices"`
+}
+
+// StreamChat sends messages to /chat/completions with streaming enabled and
+// invokes onDelta for each token chunk as it arrives over the SSE response.
+func (c *GrokClient) StreamChat(messages []Message, onDelta func(string)) error {
+    start := time.Now()
+    correlationID := newCorrelationID()
+    const endpoint = "/chat/completions"
+
+    reqBody, err := json.Marshal(chatCompletionRequest{Messages: messages, Stream: true})
+    if err != nil {
+        c.logger.Error("marshaling chat request body", "correlation_id", correlationID, "endpoint", endpoint, "error", err)
+        return fmt.Errorf("internal error preparing request")
+    }
+
+    req, err := http.NewRequest("POST", c.baseURL+endpoint, bytes.NewBuffer(reqBody))
+    if err != nil {
+        c.logger.Error("creating stream request", "correlation_id", correlationID, "endpoint", endpoint, "error", err)
+        return fmt.Errorf("failed to create request")
+    }
+    req.Header.Set("Authorization", "Bearer "+c.apiKey)
+    req.Header.Set("Content-Type", "application/json")
+    req.Header.Set("Accept", "text/event-stream")
+    req.Header.Set("X-Correlation-ID", correlationID)
+
+    resp, err := c.client.Do(req)
+    if err != nil {
+        c.logger.Error("stream request failed", "correlation_id", correlationID, "endpoint", endpoint, "latency_ms", time.Since(start).Milliseconds(), "error", err)
+        return fmt.Errorf("network error: unable to reach API")
+    }
+    defer resp.Body.Close()
+
+    if resp.StatusCode != http.StatusOK {
+        data, _ := ioutil.ReadAll(resp.Body)
+        c.logger.Error("request completed", "correlation_id", correlationID, "endpoint", endpoint, "status", resp.StatusCode, "latency_ms", time.Since(start).Milliseconds(), "body", sanitizeBody(data))
+        return fmt.Errorf("API error: received status %d", resp.StatusCode)
+    }
+
+    scanner := bufio.NewScanner(resp.Body)
+    for scanner.Scan() {
+        line := strings.TrimSpace(scanner.Text())
+        if line == "" || !strings.HasPrefix(line, "data: ") {
+            continue
+        }
+        payload := strings.TrimPrefix(line, "data: ")
+        if payload == "[DONE]" {
+            break
+        }
+
+        var chunk streamChunk
+        if err := json.Unmarshal([]byte(payload), &chunk); err != nil {
+            c.logger.Warn("parsing stream chunk", "correlation_id", correlationID, "error", err)
+            continue
+        }
+        for _, choice := range chunk.Choices {
+            if choice.Delta.Content != "" {
+                onDelta(choice.Delta.Content)
+            }
+        }
+    }
+    if err := scanner.Err(); err != nil {
+        c.logger.Error("reading stream response", "correlation_id", correlationID, "endpoint", endpoint, "latency_ms", time.Since(start).Milliseconds(), "error", err)
+        return fmt.Errorf("error reading stream response")
+    }
+
+    c.logger.Info("request completed", "correlation_id", correlationID, "endpoint", endpoint, "status", resp.StatusCode, "latency_ms", time.Since(start).Milliseconds())
+    return nil
+}
+
+// ChatSession maintains role-tagged message history across turns so the REPL
+// can carry multi-turn context instead of one-shot question/answer calls. The
+// mutex lets a single session be shared across concurrent Ask calls, as the
+// `serve` gateway does when handling concurrent REST/gRPC requests.
+type ChatSession struct {
+    client  *GrokClient
+    mu      sync.Mutex
+    history []Message
+}
+
+// NewChatSession creates a ChatSession, optionally seeded with a system prompt.
+func NewChatSession(client *GrokClient, systemPrompt string) *ChatSession {
+    session := &ChatSession{client: client}
+    if systemPrompt != "" {
+        session.history = append(session.history, Message{Role: "system", Content: systemPrompt})
+    }
+    return session
+}
+
+// Ask appends question to the session history, streams the assistant's reply
+// through onDelta, and records the reply so later turns see the full context.
+// It holds the session lock for the duration of the call, serializing turns
+// so concurrent callers (e.g. the `serve` gateway) can't interleave history
+// mutations.
+func (s *ChatSession) Ask(question string, onDelta func(string)) (string, error) {
+    s.mu.Lock()
+    defer s.mu.Unlock()
+
+    s.history = append(s.history, Message{Role: "user", Content: question})
+
+    var answer strings.Builder
+    err := s.client.StreamChat(s.history, func(delta string) {
+        answer.WriteString(delta)
+        onDelta(delta)
+    })
+    if err != nil {
+        // Drop the unanswered question so it doesn't pollute future context.
+        s.history = s.history[:len(s.history)-1]
+        return "", err
+    }
+
+    reply := answer.String()
+    s.history = append(s.history, Message{Role: "assistant", Content: reply})
+    return reply, nil
+}
+
+// Reset clears the conversation history, keeping the system prompt if one was set.
+func (s *ChatSession) Reset() {
+    s.mu.Lock()
+    defer s.mu.Unlock()
+
+    if len(s.history) > 0 && s.history[0].Role == "system" {
+        s.history = s.history[:1]
+        return
+    }
+    s.history = nil
+}
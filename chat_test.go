@@ -0,0 +1,131 @@
+package main
+
+import (
+    "net/http"
+    "net/http/httptest"
+    "strings"
+    "testing"
+)
+
+func TestStreamChatDeliversDeltasInOrder(t *testing.T) {
+    srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+        w.Header().Set("Content-Type", "text/event-stream")
+        io := `data: {"choices":[{"delta":{"content":"Hel"}}]}` + "\n\n" +
+            `data: {"choices":[{"delta":{"content":"lo, "}}]}` + "\n\n" +
+            `data: {"choices":[{"delta":{"content":""}}]}` + "\n\n" +
+            `data: {"choices":[{"delta":{"content":"world"}}]}` + "\n\n" +
+            "data: [DONE]\n\n"
+        w.Write([]byte(io))
+    }))
+    defer srv.Close()
+
+    client := &GrokClient{baseURL: srv.URL, client: srv.Client(), logger: noopLogger{}}
+
+    var got []string
+    err := client.StreamChat([]Message{{Role: "user", Content: "hi"}}, func(delta string) {
+        got = append(got, delta)
+    })
+    if err != nil {
+        t.Fatalf("StreamChat() error = %v", err)
+    }
+
+    want := []string{"Hel", "lo, ", "world"}
+    if len(got) != len(want) {
+        t.Fatalf("deltas = %v, want %v", got, want)
+    }
+    for i := range want {
+        if got[i] != want[i] {
+            t.Errorf("deltas[%d] = %q, want %q", i, got[i], want[i])
+        }
+    }
+    if strings.Join(got, "") != "Hello, world" {
+        t.Errorf("joined deltas = %q, want %q", strings.Join(got, ""), "Hello, world")
+    }
+}
+
+func TestStreamChatUpstreamError(t *testing.T) {
+    srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+        w.WriteHeader(http.StatusInternalServerError)
+        w.Write([]byte("boom"))
+    }))
+    defer srv.Close()
+
+    client := &GrokClient{baseURL: srv.URL, client: srv.Client(), logger: noopLogger{}}
+    err := client.StreamChat([]Message{{Role: "user", Content: "hi"}}, func(string) {})
+    if err == nil {
+        t.Fatal("StreamChat() error = nil, want error on non-200 response")
+    }
+}
+
+func TestChatSessionAskAppendsHistoryOnSuccess(t *testing.T) {
+    srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+        w.Write([]byte(`data: {"choices":[{"delta":{"content":"hi there"}}]}` + "\n\ndata: [DONE]\n\n"))
+    }))
+    defer srv.Close()
+
+    client := &GrokClient{baseURL: srv.URL, client: srv.Client(), logger: noopLogger{}}
+    session := NewChatSession(client, "")
+
+    reply, err := session.Ask("hello", func(string) {})
+    if err != nil {
+        t.Fatalf("Ask() error = %v", err)
+    }
+    if reply != "hi there" {
+        t.Errorf("reply = %q, want %q", reply, "hi there")
+    }
+    if len(session.history) != 2 {
+        t.Fatalf("history = %v, want 2 entries", session.history)
+    }
+    if session.history[0].Role != "user" || session.history[0].Content != "hello" {
+        t.Errorf("history[0] = %+v, want user/hello", session.history[0])
+    }
+    if session.history[1].Role != "assistant" || session.history[1].Content != "hi there" {
+        t.Errorf("history[1] = %+v, want assistant/hi there", session.history[1])
+    }
+}
+
+func TestChatSessionAskPopsQuestionOnFailure(t *testing.T) {
+    srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+        w.WriteHeader(http.StatusInternalServerError)
+    }))
+    defer srv.Close()
+
+    client := &GrokClient{baseURL: srv.URL, client: srv.Client(), logger: noopLogger{}}
+    session := NewChatSession(client, "system prompt")
+
+    if _, err := session.Ask("hello", func(string) {}); err == nil {
+        t.Fatal("Ask() error = nil, want error on upstream failure")
+    }
+
+    if len(session.history) != 1 {
+        t.Fatalf("history = %v, want only the system prompt to remain", session.history)
+    }
+    if session.history[0].Role != "system" {
+        t.Errorf("history[0].Role = %q, want %q", session.history[0].Role, "system")
+    }
+}
+
+func TestChatSessionResetKeepsSystemPrompt(t *testing.T) {
+    session := NewChatSession(&GrokClient{logger: noopLogger{}}, "system prompt")
+    session.history = append(session.history, Message{Role: "user", Content: "hello"})
+
+    session.Reset()
+
+    if len(session.history) != 1 {
+        t.Fatalf("history = %v, want only the system prompt to remain", session.history)
+    }
+    if session.history[0].Content != "system prompt" {
+        t.Errorf("history[0].Content = %q, want %q", session.history[0].Content, "system prompt")
+    }
+}
+
+func TestChatSessionResetWithoutSystemPromptClearsHistory(t *testing.T) {
+    session := NewChatSession(&GrokClient{logger: noopLogger{}}, "")
+    session.history = append(session.history, Message{Role: "user", Content: "hello"})
+
+    session.Reset()
+
+    if len(session.history) != 0 {
+        t.Errorf("history = %v, want empty", session.history)
+    }
+}
@@ -0,0 +1,402 @@
+package main
+
+import (
+    "bytes"
+    "crypto/tls"
+    "crypto/x509"
+    "encoding/json"
+    "fmt"
+    "io/ioutil"
+    "math/rand"
+    "net/http"
+    "os"
+    "strconv"
+    "strings"
+    "time"
+
+    "github.com/daley-mottley/grok-app/analyzer"
+    "github.com/joho/godotenv" // For loading .env file
+    "golang.org/x/time/rate"
+)
+
+// GrokClient handles interactions with the Grok API
+type GrokClient struct {
+    apiKey  string
+    baseURL string
+    client  *http.Client
+    logger  Logger
+
+    retryMaxAttempts int
+    retryBackoff     time.Duration
+    limiter          *rate.Limiter
+}
+
+// ClientOption configures a GrokClient. Pass any number of them to NewGrokClient.
+type ClientOption func(*GrokClient) error
+
+// WithHTTPClient overrides the underlying *http.Client used for requests.
+func WithHTTPClient(hc *http.Client) ClientOption {
+    return func(c *GrokClient) error {
+        c.client = hc
+        return nil
+    }
+}
+
+// WithBaseURL overrides the default Grok API base URL.
+func WithBaseURL(url string) ClientOption {
+    return func(c *GrokClient) error {
+        c.baseURL = url
+        return nil
+    }
+}
+
+// WithTimeout sets the per-request timeout on the underlying http.Client.
+func WithTimeout(d time.Duration) ClientOption {
+    return func(c *GrokClient) error {
+        c.client.Timeout = d
+        return nil
+    }
+}
+
+// WithRetry enables retrying failed requests, making at most maxAttempts
+// attempts in total (the first try included), using backoff (with jitter,
+// doubling per attempt) between tries.
+func WithRetry(maxAttempts int, backoff time.Duration) ClientOption {
+    return func(c *GrokClient) error {
+        if maxAttempts < 1 {
+            return fmt.Errorf("maxAttempts must be at least 1")
+        }
+        c.retryMaxAttempts = maxAttempts
+        c.retryBackoff = backoff
+        return nil
+    }
+}
+
+// WithRateLimit caps outgoing requests to rps requests per second, allowing
+// bursts of up to burst requests.
+func WithRateLimit(rps float64, burst int) ClientOption {
+    return func(c *GrokClient) error {
+        c.limiter = rate.NewLimiter(rate.Limit(rps), burst)
+        return nil
+    }
+}
+
+// WithLogger overrides the structured logger used for request events. The
+// default, built by NewLogger, honors GROK_LOG_LEVEL and GROK_LOG_FORMAT.
+func WithLogger(logger Logger) ClientOption {
+    return func(c *GrokClient) error {
+        c.logger = logger
+        return nil
+    }
+}
+
+// WithTLSConfig configures mutual TLS using a client certificate/key pair and
+// a CA bundle, for talking to self-hosted gateways behind mTLS.
+func WithTLSConfig(certFile, keyFile, caFile string) ClientOption {
+    return func(c *GrokClient) error {
+        cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+        if err != nil {
+            return fmt.Errorf("loading client keypair: %w", err)
+        }
+
+        caCert, err := ioutil.ReadFile(caFile)
+        if err != nil {
+            return fmt.Errorf("reading CA file: %w", err)
+        }
+        caPool := x509.NewCertPool()
+        if !caPool.AppendCertsFromPEM(caCert) {
+            return fmt.Errorf("failed to parse CA certificate from %s", caFile)
+        }
+
+        transport, ok := c.client.Transport.(*http.Transport)
+        if !ok || transport == nil {
+            transport = http.DefaultTransport.(*http.Transport).Clone()
+        }
+        transport.TLSClientConfig = &tls.Config{
+            Certificates: []tls.Certificate{cert},
+            RootCAs:      caPool,
+        }
+        c.client.Transport = transport
+        return nil
+    }
+}
+
+// retryTransport wraps a RoundTripper with retry-with-backoff and rate limiting.
+type retryTransport struct {
+    next        http.RoundTripper
+    maxAttempts int
+    backoff     time.Duration
+    limiter     *rate.Limiter
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+    var lastErr error
+    for attempt := 1; attempt <= t.maxAttempts; attempt++ {
+        if t.limiter != nil {
+            if err := t.limiter.Wait(req.Context()); err != nil {
+                return nil, err
+            }
+        }
+
+        attemptReq := req
+        if attempt > 1 && req.GetBody != nil {
+            body, err := req.GetBody()
+            if err != nil {
+                return nil, fmt.Errorf("rewinding request body for retry: %w", err)
+            }
+            attemptReq = req.Clone(req.Context())
+            attemptReq.Body = body
+        }
+
+        resp, err := t.next.RoundTrip(attemptReq)
+        if err == nil && resp.StatusCode != http.StatusTooManyRequests && resp.StatusCode != http.StatusServiceUnavailable {
+            return resp, nil
+        }
+
+        if attempt == t.maxAttempts {
+            if err != nil {
+                return nil, err
+            }
+            return resp, nil
+        }
+
+        var wait time.Duration
+        if err != nil {
+            lastErr = err
+            wait = backoffWithJitter(t.backoff, attempt)
+        } else {
+            lastErr = fmt.Errorf("received status %d", resp.StatusCode)
+            wait = retryAfterOrBackoff(resp, t.backoff, attempt)
+            resp.Body.Close()
+        }
+
+        select {
+        case <-time.After(wait):
+        case <-req.Context().Done():
+            return nil, req.Context().Err()
+        }
+    }
+    return nil, lastErr
+}
+
+// retryAfterOrBackoff honors a Retry-After header (seconds) if present,
+// falling back to exponential backoff with jitter.
+func retryAfterOrBackoff(resp *http.Response, backoff time.Duration, attempt int) time.Duration {
+    if retryAfter := resp.Header.Get("Retry-After"); retryAfter != "" {
+        if secs, err := strconv.Atoi(retryAfter); err == nil {
+            return time.Duration(secs) * time.Second
+        }
+    }
+    return backoffWithJitter(backoff, attempt)
+}
+
+// backoffWithJitter returns a random duration in [0, backoff*2^attempt), so
+// concurrent clients don't retry in lockstep.
+func backoffWithJitter(backoff time.Duration, attempt int) time.Duration {
+    max := backoff * time.Duration(int64(1)<<uint(attempt))
+    if max <= 0 {
+        return backoff
+    }
+    return time.Duration(rand.Int63n(int64(max)))
+}
+
+// NewGrokClient initializes a new Grok API client. Options are applied in
+// order; the last WithHTTPClient/WithBaseURL/etc. wins.
+func NewGrokClient(opts ...ClientOption) (*GrokClient, error) {
+    logger, err := NewLogger()
+    if err != nil {
+        return nil, fmt.Errorf("building logger: %w", err)
+    }
+
+    // Load .env file if it exists
+    if err := godotenv.Load(); err != nil {
+        logger.Warn("loading .env file", "error", err)
+        // Continue; we’ll fall back to os.Getenv
+    }
+
+    apiKey := os.Getenv("GROK_API_KEY")
+    if apiKey == "" {
+        return nil, fmt.Errorf("GROK_API_KEY environment variable is not set")
+    }
+
+    c := &GrokClient{
+        apiKey:           apiKey,
+        baseURL:          "https://api.grok.ai/v1", // Hypothetical URL
+        client:           &http.Client{},
+        logger:           logger,
+        retryMaxAttempts: 1,
+        retryBackoff:     500 * time.Millisecond,
+    }
+
+    for _, opt := range opts {
+        if err := opt(c); err != nil {
+            return nil, fmt.Errorf("applying client option: %w", err)
+        }
+    }
+
+    base := c.client.Transport
+    if base == nil {
+        base = http.DefaultTransport
+    }
+    c.client.Transport = &retryTransport{
+        next:        base,
+        maxAttempts: c.retryMaxAttempts,
+        backoff:     c.retryBackoff,
+        limiter:     c.limiter,
+    }
+
+    return c, nil
+}
+
+// makeRequest sends an HTTP request to the Grok API, timing the call and
+// emitting a single structured log event describing the outcome.
+func (c *GrokClient) makeRequest(method, endpoint string, body interface{}) ([]byte, error) {
+    start := time.Now()
+    correlationID := newCorrelationID()
+
+    url := c.baseURL + endpoint
+    var req *http.Request
+    var err error
+
+    if body != nil {
+        jsonBody, merr := json.Marshal(body)
+        if merr != nil {
+            c.logger.Error("marshaling request body", "correlation_id", correlationID, "endpoint", endpoint, "error", merr)
+            return nil, fmt.Errorf("internal error preparing request")
+        }
+        req, err = http.NewRequest(method, url, bytes.NewBuffer(jsonBody))
+    } else {
+        req, err = http.NewRequest(method, url, nil)
+    }
+    if err != nil {
+        c.logger.Error("creating request", "correlation_id", correlationID, "endpoint", endpoint, "error", err)
+        return nil, fmt.Errorf("failed to create request")
+    }
+
+    req.Header.Set("Authorization", "Bearer "+c.apiKey)
+    req.Header.Set("Content-Type", "application/json")
+    req.Header.Set("X-Correlation-ID", correlationID)
+
+    resp, err := c.client.Do(req)
+    if err != nil {
+        c.logger.Error("request failed", "correlation_id", correlationID, "endpoint", endpoint, "latency_ms", time.Since(start).Milliseconds(), "error", err)
+        return nil, fmt.Errorf("network error: unable to reach API")
+    }
+    defer resp.Body.Close()
+
+    data, err := ioutil.ReadAll(resp.Body)
+    if err != nil {
+        c.logger.Error("reading response body", "correlation_id", correlationID, "endpoint", endpoint, "status", resp.StatusCode, "latency_ms", time.Since(start).Milliseconds(), "error", err)
+        return nil, fmt.Errorf("failed to read API response")
+    }
+
+    latencyMs := time.Since(start).Milliseconds()
+    if resp.StatusCode != http.StatusOK {
+        c.logger.Error("request completed", "correlation_id", correlationID, "endpoint", endpoint, "status", resp.StatusCode, "latency_ms", latencyMs, "body", sanitizeBody(data))
+        return nil, fmt.Errorf("API error: received status %d", resp.StatusCode)
+    }
+
+    c.logger.Info("request completed", "correlation_id", correlationID, "endpoint", endpoint, "status", resp.StatusCode, "latency_ms", latencyMs)
+    return data, nil
+}
+
+// GenerateImage sends a prompt for image generation
+func (c *GrokClient) GenerateImage(prompt string) (string, error) {
+    body := map[string]string{"prompt": prompt}
+    data, err := c.makeRequest("POST", "/image", body)
+    if err != nil {
+        return "", err
+    }
+    var result map[string]interface{}
+    if err := json.Unmarshal(data, &result); err != nil {
+        c.logger.Error("parsing image response", "error", err)
+        return "", fmt.Errorf("invalid API response")
+    }
+    url, ok := result["image_url"].(string)
+    if !ok {
+        c.logger.Error("image URL not found in response")
+        return "", fmt.Errorf("no image URL provided by API")
+    }
+    return url, nil
+}
+
+// AnalyzeData performs basic analysis on a comma-separated list of numbers.
+func (c *GrokClient) AnalyzeData(data string) (map[string]float64, error) {
+    return c.AnalyzeDataWithOptions(data, analyzer.Options{})
+}
+
+// AnalyzeDataWithOptions behaves like AnalyzeData, but lets the caller
+// configure which percentiles the local fallback analyzer computes. It
+// falls back to the in-process analyzer (skipping the remote API) when the
+// remote /analyze endpoint is unreachable or GROK_ANALYZE_LOCAL=1 is set.
+func (c *GrokClient) AnalyzeDataWithOptions(data string, opts analyzer.Options) (map[string]float64, error) {
+    if os.Getenv("GROK_ANALYZE_LOCAL") == "1" {
+        return c.analyzeLocal(data, opts)
+    }
+
+    body := map[string]string{"data": data}
+    respData, err := c.makeRequest("POST", "/analyze", body)
+    if err != nil {
+        c.logger.Warn("remote analyze unreachable, falling back to local analyzer", "error", err)
+        return c.analyzeLocal(data, opts)
+    }
+
+    var result map[string]interface{}
+    if err := json.Unmarshal(respData, &result); err != nil {
+        c.logger.Error("parsing analyze response", "error", err)
+        return nil, fmt.Errorf("invalid API response")
+    }
+    analysis := make(map[string]float64)
+    for key, value := range result {
+        if num, ok := value.(float64); ok {
+            analysis[key] = num
+        } else {
+            c.logger.Error("invalid analysis value", "key", key, "value", value)
+            return nil, fmt.Errorf("invalid analysis data")
+        }
+    }
+    return analysis, nil
+}
+
+// analyzeLocal parses data as comma-separated numbers and computes
+// statistics in-process via the analyzer package.
+func (c *GrokClient) analyzeLocal(data string, opts analyzer.Options) (map[string]float64, error) {
+    nums, err := parseNumbers(data)
+    if err != nil {
+        return nil, err
+    }
+    return resultToMap(analyzer.Analyze(nums, opts)), nil
+}
+
+// parseNumbers parses a comma-separated list of numbers.
+func parseNumbers(data string) ([]float64, error) {
+    parts := strings.Split(data, ",")
+    nums := make([]float64, 0, len(parts))
+    for _, part := range parts {
+        n, err := strconv.ParseFloat(strings.TrimSpace(part), 64)
+        if err != nil {
+            return nil, fmt.Errorf("invalid number format in data")
+        }
+        nums = append(nums, n)
+    }
+    return nums, nil
+}
+
+// resultToMap flattens an analyzer.Result into the map[string]float64 shape
+// AnalyzeData has always returned, so callers don't need to change.
+func resultToMap(result analyzer.Result) map[string]float64 {
+    m := map[string]float64{
+        "mean":     result.Mean,
+        "median":   result.Median,
+        "stddev":   result.StdDev,
+        "variance": result.Variance,
+        "min":      result.Min,
+        "max":      result.Max,
+        "sum":      result.Sum,
+        "count":    float64(result.Count),
+    }
+    for p, v := range result.Percentiles {
+        m[fmt.Sprintf("p%v", p)] = v
+    }
+    return m
+}
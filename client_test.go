@@ -0,0 +1,100 @@
+package main
+
+import (
+    "net/http"
+    "net/http/httptest"
+    "testing"
+    "time"
+)
+
+func TestBackoffWithJitterBounds(t *testing.T) {
+    backoff := 100 * time.Millisecond
+    for attempt := 1; attempt <= 5; attempt++ {
+        max := backoff * time.Duration(int64(1)<<uint(attempt))
+        for i := 0; i < 20; i++ {
+            wait := backoffWithJitter(backoff, attempt)
+            if wait < 0 || wait >= max {
+                t.Fatalf("attempt %d: wait %v out of range [0, %v)", attempt, wait, max)
+            }
+        }
+    }
+}
+
+func TestRetryAfterOrBackoffHonorsHeader(t *testing.T) {
+    resp := &http.Response{Header: http.Header{"Retry-After": []string{"2"}}}
+    wait := retryAfterOrBackoff(resp, 100*time.Millisecond, 1)
+    if wait != 2*time.Second {
+        t.Errorf("wait = %v, want 2s", wait)
+    }
+}
+
+func TestRetryAfterOrBackoffFallsBackWithoutHeader(t *testing.T) {
+    resp := &http.Response{Header: http.Header{}}
+    backoff := 100 * time.Millisecond
+    wait := retryAfterOrBackoff(resp, backoff, 1)
+    if wait < 0 || wait >= backoff*2 {
+        t.Errorf("wait = %v, want in [0, %v)", wait, backoff*2)
+    }
+}
+
+func TestRetryTransportRetriesOnServiceUnavailable(t *testing.T) {
+    var attempts int
+    srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+        attempts++
+        if attempts < 3 {
+            w.WriteHeader(http.StatusServiceUnavailable)
+            return
+        }
+        w.WriteHeader(http.StatusOK)
+    }))
+    defer srv.Close()
+
+    transport := &retryTransport{
+        next:        http.DefaultTransport,
+        maxAttempts: 3,
+        backoff:     time.Millisecond,
+    }
+    client := &http.Client{Transport: transport}
+
+    resp, err := client.Get(srv.URL)
+    if err != nil {
+        t.Fatalf("Get: %v", err)
+    }
+    defer resp.Body.Close()
+
+    if resp.StatusCode != http.StatusOK {
+        t.Errorf("status = %d, want 200", resp.StatusCode)
+    }
+    if attempts != 3 {
+        t.Errorf("attempts = %d, want 3", attempts)
+    }
+}
+
+func TestRetryTransportGivesUpAfterMaxAttempts(t *testing.T) {
+    var attempts int
+    srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+        attempts++
+        w.WriteHeader(http.StatusServiceUnavailable)
+    }))
+    defer srv.Close()
+
+    transport := &retryTransport{
+        next:        http.DefaultTransport,
+        maxAttempts: 2,
+        backoff:     time.Millisecond,
+    }
+    client := &http.Client{Transport: transport}
+
+    resp, err := client.Get(srv.URL)
+    if err != nil {
+        t.Fatalf("Get: %v", err)
+    }
+    defer resp.Body.Close()
+
+    if resp.StatusCode != http.StatusServiceUnavailable {
+        t.Errorf("status = %d, want 503", resp.StatusCode)
+    }
+    if attempts != 2 {
+        t.Errorf("attempts = %d, want 2", attempts)
+    }
+}
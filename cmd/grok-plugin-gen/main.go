@@ -0,0 +1,97 @@
+// Command grok-plugin-gen scaffolds a new grok-app plugin package: a
+// main.go implementing the Command interface plus the build instructions
+// needed to turn it into a *.so grok-app can load from ~/.grok/plugins.
+package main
+
+import (
+    "flag"
+    "fmt"
+    "os"
+    "path/filepath"
+    "text/template"
+    "unicode"
+    "unicode/utf8"
+)
+
+const pluginTemplate = `package main
+
+import (
+    "context"
+    "fmt"
+    "io"
+)
+
+// {{.TypeName}} implements grok-app's Command interface. Build it with:
+//
+//   go build -buildmode=plugin -o {{.Name}}.so
+//
+// then drop the resulting {{.Name}}.so into ~/.grok/plugins/ so grok-app
+// picks it up at startup.
+type {{.TypeName}} struct{}
+
+func ({{.TypeName}}) Name() string { return "{{.Name}}" }
+
+func ({{.TypeName}}) Help() string { return "{{.Name}}: <args>  - TODO describe this command" }
+
+func ({{.TypeName}}) Run(ctx context.Context, args string, out io.Writer) error {
+    fmt.Fprintf(out, "{{.Name}} called with: %s\n", args)
+    return nil
+}
+
+// Plugin is the symbol grok-app looks up via plugin.Open + Lookup("Plugin").
+var Plugin {{.TypeName}}
+`
+
+type templateData struct {
+    Name     string
+    TypeName string
+}
+
+func main() {
+    outDir := flag.String("out", ".", "directory to scaffold the plugin package into")
+    flag.Parse()
+
+    if flag.NArg() != 1 {
+        fmt.Fprintln(os.Stderr, "usage: grok-plugin-gen [-out dir] <command-name>")
+        os.Exit(1)
+    }
+    name := flag.Arg(0)
+
+    data := templateData{
+        Name:     name,
+        TypeName: exportedName(name) + "Command",
+    }
+
+    dir := filepath.Join(*outDir, name)
+    if err := os.MkdirAll(dir, 0755); err != nil {
+        fmt.Fprintf(os.Stderr, "Error creating plugin directory: %v\n", err)
+        os.Exit(1)
+    }
+
+    path := filepath.Join(dir, "main.go")
+    f, err := os.Create(path)
+    if err != nil {
+        fmt.Fprintf(os.Stderr, "Error creating %s: %v\n", path, err)
+        os.Exit(1)
+    }
+    defer f.Close()
+
+    tmpl := template.Must(template.New("plugin").Parse(pluginTemplate))
+    if err := tmpl.Execute(f, data); err != nil {
+        fmt.Fprintf(os.Stderr, "Error generating plugin source: %v\n", err)
+        os.Exit(1)
+    }
+
+    fmt.Printf("Scaffolded plugin command %q in %s\n", name, dir)
+    fmt.Printf("Build it with: go build -buildmode=plugin -o %s.so ./%s\n", name, name)
+    fmt.Printf("Then install it with: cp %s.so ~/.grok/plugins/\n", name)
+}
+
+// exportedName upper-cases the first rune so the generated type name is exported.
+func exportedName(s string) string {
+    if s == "" {
+        return s
+    }
+    r, size := utf8.DecodeRuneInString(s)
+    return string(unicode.ToUpper(r)) + s[size:]
+}
@@ -0,0 +1,192 @@
+package main
+
+import (
+    "context"
+    "fmt"
+    "io"
+    "path/filepath"
+    "plugin"
+    "sort"
+    "strconv"
+    "strings"
+
+    "github.com/daley-mottley/grok-app/analyzer"
+)
+
+// Command is a single REPL command, whether built in or loaded from a plugin.
+type Command interface {
+    Name() string
+    Help() string
+    Run(ctx context.Context, args string, out io.Writer) error
+}
+
+// Registry holds the set of commands available to the REPL, discovered
+// either from static built-ins or dynamically loaded plugins.
+type Registry struct {
+    commands map[string]Command
+    logger   Logger
+}
+
+// NewRegistry creates an empty command registry.
+func NewRegistry(logger Logger) *Registry {
+    return &Registry{commands: make(map[string]Command), logger: logger}
+}
+
+// Register adds cmd to the registry, keyed by its lowercased name.
+func (r *Registry) Register(cmd Command) {
+    r.commands[strings.ToLower(cmd.Name())] = cmd
+}
+
+// Lookup returns the command registered under name, if any.
+func (r *Registry) Lookup(name string) (Command, bool) {
+    cmd, ok := r.commands[strings.ToLower(name)]
+    return cmd, ok
+}
+
+// Names returns the registered command names in sorted order.
+func (r *Registry) Names() []string {
+    names := make([]string, 0, len(r.commands))
+    for name := range r.commands {
+        names = append(names, name)
+    }
+    sort.Strings(names)
+    return names
+}
+
+// LoadPlugins discovers *.so files in dir (typically ~/.grok/plugins) and
+// registers the Command each one exports as a "Plugin" symbol. A plugin
+// that fails to load or doesn't implement Command is logged and skipped.
+func (r *Registry) LoadPlugins(dir string) error {
+    matches, err := filepath.Glob(filepath.Join(dir, "*.so"))
+    if err != nil {
+        return fmt.Errorf("globbing plugin dir %s: %w", dir, err)
+    }
+
+    for _, path := range matches {
+        p, err := plugin.Open(path)
+        if err != nil {
+            r.logger.Error("loading plugin", "path", path, "error", err)
+            continue
+        }
+        sym, err := p.Lookup("Plugin")
+        if err != nil {
+            r.logger.Error("plugin missing Plugin symbol", "path", path, "error", err)
+            continue
+        }
+        cmd, ok := sym.(Command)
+        if !ok {
+            r.logger.Error("plugin Plugin symbol does not implement Command", "path", path)
+            continue
+        }
+        r.Register(cmd)
+        r.logger.Info("loaded plugin command", "command", cmd.Name(), "path", path)
+    }
+    return nil
+}
+
+// askCommand asks a question within the REPL's chat session.
+type askCommand struct {
+    session *ChatSession
+}
+
+func (c *askCommand) Name() string { return "ask" }
+func (c *askCommand) Help() string {
+    return "ask: <question>          - Ask a question (conversation carries context)"
+}
+func (c *askCommand) Run(ctx context.Context, args string, out io.Writer) error {
+    fmt.Fprint(out, "Answer: ")
+    _, err := c.session.Ask(args, func(delta string) {
+        fmt.Fprint(out, delta)
+    })
+    fmt.Fprintln(out)
+    return err
+}
+
+// imageCommand generates an image URL from a text prompt.
+type imageCommand struct {
+    client *GrokClient
+}
+
+func (c *imageCommand) Name() string { return "image" }
+func (c *imageCommand) Help() string { return "image: <description>     - Generate an image URL" }
+func (c *imageCommand) Run(ctx context.Context, args string, out io.Writer) error {
+    url, err := c.client.GenerateImage(args)
+    if err != nil {
+        return err
+    }
+    fmt.Fprintf(out, "Image URL: %s\n", url)
+    return nil
+}
+
+// analyzeCommand analyzes a comma-separated list of numbers, with an
+// optional leading "--percentiles=p1,p2,..." flag.
+type analyzeCommand struct {
+    client *GrokClient
+    logger Logger
+}
+
+func (c *analyzeCommand) Name() string { return "analyze" }
+func (c *analyzeCommand) Help() string {
+    return "analyze: [--percentiles=p,...] <numbers>  - Analyze comma-separated numbers (e.g., 1,2,3)"
+}
+func (c *analyzeCommand) Run(ctx context.Context, args string, out io.Writer) error {
+    opts, numsArg, err := parseAnalyzeArgs(args)
+    if err != nil {
+        return err
+    }
+
+    nums := strings.Split(numsArg, ",")
+    for _, num := range nums {
+        if _, err := strconv.ParseFloat(strings.TrimSpace(num), 64); err != nil {
+            c.logger.Error("invalid number in analyze input", "value", num)
+            return fmt.Errorf("invalid number format in data")
+        }
+    }
+
+    analysis, err := c.client.AnalyzeDataWithOptions(numsArg, opts)
+    if err != nil {
+        return err
+    }
+    fmt.Fprintln(out, "Analysis Results:")
+    for key, value := range analysis {
+        fmt.Fprintf(out, "  %s: %.2f\n", key, value)
+    }
+    return nil
+}
+
+// parseAnalyzeArgs splits a leading "--percentiles=50,95" flag off args,
+// returning the requested percentiles and the remaining comma-separated
+// number list.
+func parseAnalyzeArgs(args string) (analyzer.Options, string, error) {
+    fields := strings.Fields(args)
+    if len(fields) == 0 {
+        return analyzer.Options{}, "", fmt.Errorf("no data provided")
+    }
+    if !strings.HasPrefix(fields[0], "--percentiles=") {
+        return analyzer.Options{}, args, nil
+    }
+
+    raw := strings.TrimPrefix(fields[0], "--percentiles=")
+    var percentiles []float64
+    for _, p := range strings.Split(raw, ",") {
+        v, err := strconv.ParseFloat(strings.TrimSpace(p), 64)
+        if err != nil {
+            return analyzer.Options{}, "", fmt.Errorf("invalid percentile %q", p)
+        }
+        percentiles = append(percentiles, v)
+    }
+    return analyzer.Options{Percentiles: percentiles}, strings.Join(fields[1:], " "), nil
+}
+
+// resetCommand clears the chat session's conversation history.
+type resetCommand struct {
+    session *ChatSession
+}
+
+func (c *resetCommand) Name() string { return "reset" }
+func (c *resetCommand) Help() string { return "reset                    - Clear conversation history" }
+func (c *resetCommand) Run(ctx context.Context, args string, out io.Writer) error {
+    c.session.Reset()
+    fmt.Fprintln(out, "Conversation history cleared.")
+    return nil
+}
@@ -0,0 +1,77 @@
+package main
+
+import (
+    "context"
+    "io"
+    "testing"
+)
+
+// noopLogger discards everything; tests that just need a Logger to satisfy
+// a constructor use it instead of building a real zapLogger.
+type noopLogger struct{}
+
+func (noopLogger) Trace(string, ...interface{}) {}
+func (noopLogger) Debug(string, ...interface{}) {}
+func (noopLogger) Info(string, ...interface{})  {}
+func (noopLogger) Warn(string, ...interface{})  {}
+func (noopLogger) Error(string, ...interface{}) {}
+
+type stubCommand struct {
+    name string
+}
+
+func (c *stubCommand) Name() string { return c.name }
+func (c *stubCommand) Help() string { return c.name + " help" }
+func (c *stubCommand) Run(ctx context.Context, args string, out io.Writer) error { return nil }
+
+func TestRegistryRegisterAndLookup(t *testing.T) {
+    r := NewRegistry(noopLogger{})
+    r.Register(&stubCommand{name: "Ask"})
+
+    cmd, ok := r.Lookup("ask")
+    if !ok {
+        t.Fatal("Lookup(\"ask\") = false, want true")
+    }
+    if cmd.Name() != "Ask" {
+        t.Errorf("Name() = %q, want %q", cmd.Name(), "Ask")
+    }
+
+    if _, ok := r.Lookup("ASK"); !ok {
+        t.Error("Lookup(\"ASK\") = false, want true")
+    }
+}
+
+func TestRegistryLookupMissing(t *testing.T) {
+    r := NewRegistry(noopLogger{})
+    if _, ok := r.Lookup("missing"); ok {
+        t.Error("Lookup(\"missing\") = true, want false")
+    }
+}
+
+func TestRegistryNamesSorted(t *testing.T) {
+    r := NewRegistry(noopLogger{})
+    r.Register(&stubCommand{name: "reset"})
+    r.Register(&stubCommand{name: "ask"})
+    r.Register(&stubCommand{name: "image"})
+
+    got := r.Names()
+    want := []string{"ask", "image", "reset"}
+    if len(got) != len(want) {
+        t.Fatalf("Names() = %v, want %v", got, want)
+    }
+    for i := range want {
+        if got[i] != want[i] {
+            t.Errorf("Names()[%d] = %q, want %q", i, got[i], want[i])
+        }
+    }
+}
+
+func TestRegistryLoadPluginsNoMatches(t *testing.T) {
+    r := NewRegistry(noopLogger{})
+    if err := r.LoadPlugins(t.TempDir()); err != nil {
+        t.Errorf("LoadPlugins() = %v, want nil", err)
+    }
+    if len(r.Names()) != 0 {
+        t.Errorf("Names() = %v, want empty", r.Names())
+    }
+}
@@ -0,0 +1,92 @@
+package main
+
+import (
+    "crypto/rand"
+    "encoding/hex"
+    "fmt"
+    "os"
+    "strings"
+
+    "go.uber.org/zap"
+    "go.uber.org/zap/zapcore"
+)
+
+// Logger is a structured, leveled logging interface. Each method takes a
+// message plus alternating key/value pairs, so call sites don't need to
+// build a dedicated fields type.
+type Logger interface {
+    Trace(msg string, kv ...interface{})
+    Debug(msg string, kv ...interface{})
+    Info(msg string, kv ...interface{})
+    Warn(msg string, kv ...interface{})
+    Error(msg string, kv ...interface{})
+}
+
+// zapLogger backs Logger with a *zap.SugaredLogger. zap has no trace level,
+// so Trace is logged at debug with an extra "level":"trace" field.
+type zapLogger struct {
+    sugar *zap.SugaredLogger
+}
+
+// NewLogger builds the default Logger, configured via GROK_LOG_LEVEL
+// (trace/debug/info/warn/error, default info) and GROK_LOG_FORMAT
+// (json/text, default json) so operators can ship logs to ELK/Loki
+// without regex-parsing unstructured lines.
+func NewLogger() (Logger, error) {
+    level := zapcore.InfoLevel
+    if raw := os.Getenv("GROK_LOG_LEVEL"); raw != "" {
+        if strings.EqualFold(raw, "trace") {
+            level = zapcore.DebugLevel
+        } else if err := level.UnmarshalText([]byte(raw)); err != nil {
+            return nil, fmt.Errorf("parsing GROK_LOG_LEVEL: %w", err)
+        }
+    }
+
+    var cfg zap.Config
+    if strings.EqualFold(os.Getenv("GROK_LOG_FORMAT"), "text") {
+        cfg = zap.NewDevelopmentConfig()
+        cfg.Encoding = "console"
+    } else {
+        cfg = zap.NewProductionConfig()
+        cfg.Encoding = "json"
+    }
+    cfg.Level = zap.NewAtomicLevelAt(level)
+
+    built, err := cfg.Build()
+    if err != nil {
+        return nil, fmt.Errorf("building logger: %w", err)
+    }
+    return &zapLogger{sugar: built.Sugar()}, nil
+}
+
+func (l *zapLogger) Trace(msg string, kv ...interface{}) {
+    l.sugar.Debugw(msg, append(kv, "level", "trace")...)
+}
+
+func (l *zapLogger) Debug(msg string, kv ...interface{}) { l.sugar.Debugw(msg, kv...) }
+func (l *zapLogger) Info(msg string, kv ...interface{})  { l.sugar.Infow(msg, kv...) }
+func (l *zapLogger) Warn(msg string, kv ...interface{})  { l.sugar.Warnw(msg, kv...) }
+func (l *zapLogger) Error(msg string, kv ...interface{}) { l.sugar.Errorw(msg, kv...) }
+
+// newCorrelationID returns a short random hex ID to tie together the log
+// lines for a single request.
+func newCorrelationID() string {
+    buf := make([]byte, 8)
+    if _, err := rand.Read(buf); err != nil {
+        return "unknown"
+    }
+    return hex.EncodeToString(buf)
+}
+
+// maxLoggedBodyBytes caps how much of a failed response body gets logged,
+// so a misbehaving endpoint can't flood log storage.
+const maxLoggedBodyBytes = 512
+
+// sanitizeBody truncates data for safe inclusion in a log line.
+func sanitizeBody(data []byte) string {
+    s := string(data)
+    if len(s) > maxLoggedBodyBytes {
+        return s[:maxLoggedBodyBytes] + "...(truncated)"
+    }
+    return s
+}
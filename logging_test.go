@@ -0,0 +1,54 @@
+package main
+
+import (
+    "strings"
+    "testing"
+)
+
+func TestSanitizeBodyShortPassesThrough(t *testing.T) {
+    got := sanitizeBody([]byte("short body"))
+    if got != "short body" {
+        t.Errorf("sanitizeBody() = %q, want %q", got, "short body")
+    }
+}
+
+func TestSanitizeBodyTruncatesLong(t *testing.T) {
+    body := strings.Repeat("a", maxLoggedBodyBytes+100)
+    got := sanitizeBody([]byte(body))
+
+    if !strings.HasSuffix(got, "...(truncated)") {
+        t.Errorf("sanitizeBody() = %q, want suffix %q", got, "...(truncated)")
+    }
+    wantPrefixLen := maxLoggedBodyBytes
+    if !strings.HasPrefix(got, strings.Repeat("a", wantPrefixLen)) {
+        t.Errorf("sanitizeBody() did not preserve the first %d bytes", wantPrefixLen)
+    }
+}
+
+func TestNewLoggerDefaults(t *testing.T) {
+    logger, err := NewLogger()
+    if err != nil {
+        t.Fatalf("NewLogger() error = %v", err)
+    }
+    if logger == nil {
+        t.Fatal("NewLogger() = nil, want non-nil Logger")
+    }
+}
+
+func TestNewLoggerRejectsInvalidLevel(t *testing.T) {
+    t.Setenv("GROK_LOG_LEVEL", "not-a-level")
+    if _, err := NewLogger(); err == nil {
+        t.Error("NewLogger() error = nil, want error for invalid GROK_LOG_LEVEL")
+    }
+}
+
+func TestNewCorrelationIDIsNonEmptyAndVaries(t *testing.T) {
+    a := newCorrelationID()
+    b := newCorrelationID()
+    if a == "" || b == "" {
+        t.Fatal("newCorrelationID() returned an empty string")
+    }
+    if a == b {
+        t.Errorf("newCorrelationID() returned the same ID twice: %q", a)
+    }
+}
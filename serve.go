@@ -0,0 +1,99 @@
+package main
+
+import (
+    "context"
+    "fmt"
+    "net"
+    "os"
+
+    "google.golang.org/grpc"
+
+    "github.com/daley-mottley/grok-app/server"
+)
+
+// runServe starts grok-app in gateway mode: a REST API and a gRPC service
+// in front of a single GrokClient, suitable for running as a deployable
+// microservice alongside peer instances.
+func runServe(client *GrokClient, session *ChatSession) error {
+    logger := client.logger
+
+    advertiseAddr := os.Getenv("GROK_ADVERTISE_ADDR")
+    registry := server.NewStaticRegistryFromEnv("GROK_PEERS")
+    if advertiseAddr != "" {
+        if err := registry.Register(advertiseAddr); err != nil {
+            logger.Error("registering with service registry", "addr", advertiseAddr, "error", err)
+        }
+    }
+    // Peer forwarding uses the same registry, but excludes advertiseAddr so
+    // this instance can never select itself and re-enter its own handler.
+    peers := server.NewPeerClient(registry, advertiseAddr)
+
+    gateway := server.NewGateway(
+        func(ctx context.Context, question string) (string, error) {
+            answer, err := session.Ask(question, func(string) {})
+            if err != nil {
+                if peerAnswer, peerErr := peers.Ask(ctx, question); peerErr == nil {
+                    return peerAnswer, nil
+                }
+            }
+            return answer, err
+        },
+        func(ctx context.Context, prompt string) (string, error) {
+            url, err := client.GenerateImage(prompt)
+            if err != nil {
+                if peerURL, peerErr := peers.Image(ctx, prompt); peerErr == nil {
+                    return peerURL, nil
+                }
+            }
+            return url, err
+        },
+        func(ctx context.Context, data string) (map[string]float64, error) {
+            results, err := client.AnalyzeData(data)
+            if err != nil {
+                if peerResults, peerErr := peers.Analyze(ctx, data); peerErr == nil {
+                    return peerResults, nil
+                }
+            }
+            return results, err
+        },
+        logger,
+    )
+
+    restAddr := envOrDefault("GROK_REST_ADDR", ":8080")
+    grpcAddr := envOrDefault("GROK_GRPC_ADDR", ":9090")
+
+    errCh := make(chan error, 2)
+
+    go func() {
+        logger.Info("REST gateway listening", "addr", restAddr)
+        errCh <- gateway.ServeREST(restAddr)
+    }()
+
+    go func() {
+        lis, err := net.Listen("tcp", grpcAddr)
+        if err != nil {
+            errCh <- fmt.Errorf("listening for gRPC on %s: %w", grpcAddr, err)
+            return
+        }
+        grpcServer := grpc.NewServer()
+        streamChat := func(messages []server.ChatMessage, onDelta func(string)) error {
+            msgs := make([]Message, len(messages))
+            for i, m := range messages {
+                msgs[i] = Message{Role: m.Role, Content: m.Content}
+            }
+            return client.StreamChat(msgs, onDelta)
+        }
+        server.RegisterGRPCServer(grpcServer, server.NewGRPCServer(gateway, streamChat))
+        logger.Info("gRPC service listening", "addr", grpcAddr)
+        errCh <- grpcServer.Serve(lis)
+    }()
+
+    return <-errCh
+}
+
+func envOrDefault(key, fallback string) string {
+    if v := os.Getenv(key); v != "" {
+        return v
+    }
+    return fallback
+}
@@ -0,0 +1,85 @@
+package server
+
+//go:generate ../scripts/generate-proto.sh
+
+// The stubs this file depends on are generated from proto/grok.proto into
+// proto/gen and committed alongside it; run `go generate ./...` (or
+// scripts/generate-proto.sh directly) after editing the .proto to refresh
+// them.
+
+import (
+    "context"
+
+    "google.golang.org/grpc"
+
+    grokpb "github.com/daley-mottley/grok-app/proto/gen"
+)
+
+// ChatMessage is a role-tagged chat turn, passed into the streamChat
+// callback so this package doesn't need to import package main's Message type.
+type ChatMessage struct {
+    Role    string
+    Content string
+}
+
+// GRPCServer adapts a Gateway's operations to the generated GrokService
+// gRPC interface.
+type GRPCServer struct {
+    grokpb.UnimplementedGrokServiceServer
+    gateway    *Gateway
+    streamChat func(messages []ChatMessage, onDelta func(string)) error
+}
+
+// NewGRPCServer builds a GRPCServer fronting gateway, with streamChat used
+// to service the server-streaming ChatStream RPC.
+func NewGRPCServer(gateway *Gateway, streamChat func([]ChatMessage, func(string)) error) *GRPCServer {
+    return &GRPCServer{gateway: gateway, streamChat: streamChat}
+}
+
+// RegisterGRPCServer wires s into grpcServer, ready to Serve.
+func RegisterGRPCServer(grpcServer *grpc.Server, s *GRPCServer) {
+    grokpb.RegisterGrokServiceServer(grpcServer, s)
+}
+
+func (s *GRPCServer) Ask(ctx context.Context, req *grokpb.AskRequest) (*grokpb.AskResponse, error) {
+    answer, err := s.gateway.Ask(ctx, req.GetQuestion())
+    if err != nil {
+        return nil, err
+    }
+    return &grokpb.AskResponse{Answer: answer}, nil
+}
+
+func (s *GRPCServer) GenerateImage(ctx context.Context, req *grokpb.GenerateImageRequest) (*grokpb.GenerateImageResponse, error) {
+    url, err := s.gateway.Image(ctx, req.GetPrompt())
+    if err != nil {
+        return nil, err
+    }
+    return &grokpb.GenerateImageResponse{ImageUrl: url}, nil
+}
+
+func (s *GRPCServer) AnalyzeData(ctx context.Context, req *grokpb.AnalyzeDataRequest) (*grokpb.AnalyzeDataResponse, error) {
+    results, err := s.gateway.Analyze(ctx, req.GetData())
+    if err != nil {
+        return nil, err
+    }
+    return &grokpb.AnalyzeDataResponse{Results: results}, nil
+}
+
+func (s *GRPCServer) ChatStream(req *grokpb.ChatStreamRequest, stream grokpb.GrokService_ChatStreamServer) error {
+    messages := make([]ChatMessage, 0, len(req.GetMessages()))
+    for _, m := range req.GetMessages() {
+        messages = append(messages, ChatMessage{Role: m.GetRole(), Content: m.GetContent()})
+    }
+
+    var sendErr error
+    err := s.streamChat(messages, func(delta string) {
+        if sendErr != nil {
+            return
+        }
+        sendErr = stream.Send(&grokpb.ChatStreamChunk{Delta: delta})
+    })
+    if sendErr != nil {
+        return sendErr
+    }
+    return err
+}
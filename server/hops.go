@@ -0,0 +1,26 @@
+package server
+
+import "context"
+
+// HopsHeader carries how many times a request has already been forwarded
+// between `serve` instances, so a peer can refuse to bounce it further.
+const HopsHeader = "X-Grok-Hops"
+
+// MaxForwardHops caps how many times a single request may be forwarded to a
+// peer before a gateway must give up and return its own local error instead.
+const MaxForwardHops = 1
+
+type hopsKey struct{}
+
+// ContextWithHops returns a context carrying hops, the number of times the
+// in-flight request has already been forwarded between `serve` instances.
+func ContextWithHops(ctx context.Context, hops int) context.Context {
+    return context.WithValue(ctx, hopsKey{}, hops)
+}
+
+// HopsFromContext returns the forwarding depth stored by ContextWithHops, or
+// 0 if none was set.
+func HopsFromContext(ctx context.Context) int {
+    hops, _ := ctx.Value(hopsKey{}).(int)
+    return hops
+}
@@ -0,0 +1,104 @@
+package server
+
+import (
+    "bytes"
+    "context"
+    "encoding/json"
+    "fmt"
+    "net/http"
+    "strconv"
+    "time"
+)
+
+// PeerClient calls another `serve` instance's REST API, picking which peer
+// to call via a RandomSelector over the shared registry. It's the client
+// side of the mesh: a gateway forwards to a peer instead of answering
+// locally when its own GrokClient can't.
+type PeerClient struct {
+    selector *RandomSelector
+    http     *http.Client
+}
+
+// NewPeerClient builds a PeerClient that load-balances across registry's
+// discoverable peers, excluding selfAddr (this instance's own advertised
+// address) so a node can never select and forward a request to itself.
+func NewPeerClient(registry ServiceRegistry, selfAddr string) *PeerClient {
+    return &PeerClient{
+        selector: NewRandomSelector(registry, selfAddr),
+        http:     &http.Client{Timeout: 10 * time.Second},
+    }
+}
+
+// Ask forwards question to a randomly selected peer's POST /v1/ask.
+func (p *PeerClient) Ask(ctx context.Context, question string) (string, error) {
+    var resp struct {
+        Answer string `json:"answer"`
+    }
+    if err := p.call(ctx, "/v1/ask", map[string]string{"question": question}, &resp); err != nil {
+        return "", err
+    }
+    return resp.Answer, nil
+}
+
+// Image forwards prompt to a randomly selected peer's POST /v1/image.
+func (p *PeerClient) Image(ctx context.Context, prompt string) (string, error) {
+    var resp struct {
+        ImageURL string `json:"image_url"`
+    }
+    if err := p.call(ctx, "/v1/image", map[string]string{"prompt": prompt}, &resp); err != nil {
+        return "", err
+    }
+    return resp.ImageURL, nil
+}
+
+// Analyze forwards data to a randomly selected peer's POST /v1/analyze.
+func (p *PeerClient) Analyze(ctx context.Context, data string) (map[string]float64, error) {
+    var resp map[string]float64
+    if err := p.call(ctx, "/v1/analyze", map[string]string{"data": data}, &resp); err != nil {
+        return nil, err
+    }
+    return resp, nil
+}
+
+// call selects a peer, POSTs body as JSON to path on it, and decodes the
+// response into out. It refuses to forward once ctx's hop count has
+// reached MaxForwardHops, and tags the outgoing request with the
+// incremented count so the receiving peer applies the same limit.
+func (p *PeerClient) call(ctx context.Context, path string, body interface{}, out interface{}) error {
+    hops := HopsFromContext(ctx)
+    if hops >= MaxForwardHops {
+        return fmt.Errorf("not forwarding: already at max hop count (%d)", hops)
+    }
+
+    addr, err := p.selector.Select()
+    if err != nil {
+        return fmt.Errorf("selecting peer: %w", err)
+    }
+
+    payload, err := json.Marshal(body)
+    if err != nil {
+        return fmt.Errorf("marshaling peer request: %w", err)
+    }
+
+    url := "http://" + addr + path
+    req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(payload))
+    if err != nil {
+        return fmt.Errorf("building peer request: %w", err)
+    }
+    req.Header.Set("Content-Type", "application/json")
+    req.Header.Set(HopsHeader, strconv.Itoa(hops+1))
+
+    resp, err := p.http.Do(req)
+    if err != nil {
+        return fmt.Errorf("calling peer %s: %w", addr, err)
+    }
+    defer resp.Body.Close()
+
+    if resp.StatusCode != http.StatusOK {
+        return fmt.Errorf("peer %s returned status %d", addr, resp.StatusCode)
+    }
+    if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+        return fmt.Errorf("decoding peer response: %w", err)
+    }
+    return nil
+}
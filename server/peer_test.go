@@ -0,0 +1,54 @@
+package server
+
+import (
+    "context"
+    "net/http"
+    "net/http/httptest"
+    "strings"
+    "testing"
+)
+
+func TestPeerClientAskSetsHopsHeader(t *testing.T) {
+    var gotHops string
+    srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+        gotHops = r.Header.Get(HopsHeader)
+        w.Header().Set("Content-Type", "application/json")
+        w.Write([]byte(`{"answer":"hi"}`))
+    }))
+    defer srv.Close()
+
+    reg := &StaticRegistry{}
+    reg.Register(strings.TrimPrefix(srv.URL, "http://"))
+    peers := NewPeerClient(reg, "")
+
+    answer, err := peers.Ask(context.Background(), "question")
+    if err != nil {
+        t.Fatalf("Ask() error = %v", err)
+    }
+    if answer != "hi" {
+        t.Errorf("Ask() = %q, want %q", answer, "hi")
+    }
+    if gotHops != "1" {
+        t.Errorf("hops header = %q, want %q", gotHops, "1")
+    }
+}
+
+func TestPeerClientRefusesToForwardAtMaxHops(t *testing.T) {
+    called := false
+    srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+        called = true
+    }))
+    defer srv.Close()
+
+    reg := &StaticRegistry{}
+    reg.Register(strings.TrimPrefix(srv.URL, "http://"))
+    peers := NewPeerClient(reg, "")
+
+    ctx := ContextWithHops(context.Background(), MaxForwardHops)
+    if _, err := peers.Ask(ctx, "question"); err == nil {
+        t.Fatal("Ask() error = nil, want error at max hop count")
+    }
+    if called {
+        t.Error("peer was called despite being at max hop count")
+    }
+}
@@ -0,0 +1,98 @@
+package server
+
+import (
+    "fmt"
+    "math/rand"
+    "os"
+    "strings"
+)
+
+// ServiceRegistry lets a `serve` instance announce itself and discover
+// peers, so multiple instances can be found and load-balanced across.
+// Consul/etcd/mDNS backends can implement this interface; StaticRegistry
+// below is the zero-dependency default.
+type ServiceRegistry interface {
+    Register(addr string) error
+    Deregister(addr string) error
+    Discover() ([]string, error)
+}
+
+// StaticRegistry discovers peers from a fixed, pre-configured address list.
+// It's the default registry when no Consul/etcd/mDNS integration is wired up.
+type StaticRegistry struct {
+    peers []string
+}
+
+// NewStaticRegistryFromEnv builds a StaticRegistry from a comma-separated
+// list of host:port addresses read from the given environment variable.
+func NewStaticRegistryFromEnv(envVar string) *StaticRegistry {
+    raw := os.Getenv(envVar)
+    if raw == "" {
+        return &StaticRegistry{}
+    }
+    var peers []string
+    for _, addr := range strings.Split(raw, ",") {
+        if addr = strings.TrimSpace(addr); addr != "" {
+            peers = append(peers, addr)
+        }
+    }
+    return &StaticRegistry{peers: peers}
+}
+
+// Register adds addr to the known peer list.
+func (r *StaticRegistry) Register(addr string) error {
+    r.peers = append(r.peers, addr)
+    return nil
+}
+
+// Deregister removes addr from the known peer list.
+func (r *StaticRegistry) Deregister(addr string) error {
+    filtered := r.peers[:0]
+    for _, p := range r.peers {
+        if p != addr {
+            filtered = append(filtered, p)
+        }
+    }
+    r.peers = filtered
+    return nil
+}
+
+// Discover returns the currently known peer addresses.
+func (r *StaticRegistry) Discover() ([]string, error) {
+    return r.peers, nil
+}
+
+// RandomSelector picks a random peer address from a registry on each call,
+// giving simple client-side load balancing across discovered instances.
+type RandomSelector struct {
+    registry ServiceRegistry
+    selfAddr string
+}
+
+// NewRandomSelector builds a RandomSelector over the given registry. Peers
+// matching selfAddr are excluded from Select, since a node's own advertised
+// address can end up in the same registry it discovers peers from, and a
+// node must never select and forward a request to itself.
+func NewRandomSelector(registry ServiceRegistry, selfAddr string) *RandomSelector {
+    return &RandomSelector{registry: registry, selfAddr: selfAddr}
+}
+
+// Select returns a random peer address excluding selfAddr, or an error if
+// none are discoverable.
+func (s *RandomSelector) Select() (string, error) {
+    peers, err := s.registry.Discover()
+    if err != nil {
+        return "", fmt.Errorf("discovering peers: %w", err)
+    }
+
+    candidates := peers[:0:0]
+    for _, addr := range peers {
+        if addr != s.selfAddr {
+            candidates = append(candidates, addr)
+        }
+    }
+    if len(candidates) == 0 {
+        return "", fmt.Errorf("no peer instances available")
+    }
+    return candidates[rand.Intn(len(candidates))], nil
+}
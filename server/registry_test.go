@@ -0,0 +1,102 @@
+package server
+
+import "testing"
+
+func TestStaticRegistryFromEnv(t *testing.T) {
+    t.Setenv("GROK_TEST_PEERS", " 10.0.0.1:8080 , 10.0.0.2:8080,")
+    reg := NewStaticRegistryFromEnv("GROK_TEST_PEERS")
+
+    peers, err := reg.Discover()
+    if err != nil {
+        t.Fatalf("Discover() error = %v", err)
+    }
+    want := []string{"10.0.0.1:8080", "10.0.0.2:8080"}
+    if len(peers) != len(want) {
+        t.Fatalf("Discover() = %v, want %v", peers, want)
+    }
+    for i := range want {
+        if peers[i] != want[i] {
+            t.Errorf("Discover()[%d] = %q, want %q", i, peers[i], want[i])
+        }
+    }
+}
+
+func TestStaticRegistryFromEnvEmpty(t *testing.T) {
+    t.Setenv("GROK_TEST_PEERS_EMPTY", "")
+    reg := NewStaticRegistryFromEnv("GROK_TEST_PEERS_EMPTY")
+
+    peers, err := reg.Discover()
+    if err != nil {
+        t.Fatalf("Discover() error = %v", err)
+    }
+    if len(peers) != 0 {
+        t.Errorf("Discover() = %v, want empty", peers)
+    }
+}
+
+func TestStaticRegistryRegisterAndDeregister(t *testing.T) {
+    reg := &StaticRegistry{}
+    if err := reg.Register("a:1"); err != nil {
+        t.Fatalf("Register() error = %v", err)
+    }
+    if err := reg.Register("b:2"); err != nil {
+        t.Fatalf("Register() error = %v", err)
+    }
+
+    if err := reg.Deregister("a:1"); err != nil {
+        t.Fatalf("Deregister() error = %v", err)
+    }
+    peers, _ := reg.Discover()
+    if len(peers) != 1 || peers[0] != "b:2" {
+        t.Errorf("Discover() = %v, want [b:2]", peers)
+    }
+}
+
+func TestRandomSelectorPicksAmongPeers(t *testing.T) {
+    reg := &StaticRegistry{}
+    reg.Register("a:1")
+    reg.Register("b:2")
+    selector := NewRandomSelector(reg, "")
+
+    seen := map[string]bool{}
+    for i := 0; i < 50; i++ {
+        addr, err := selector.Select()
+        if err != nil {
+            t.Fatalf("Select() error = %v", err)
+        }
+        if addr != "a:1" && addr != "b:2" {
+            t.Fatalf("Select() = %q, want a:1 or b:2", addr)
+        }
+        seen[addr] = true
+    }
+    if len(seen) != 2 {
+        t.Errorf("Select() only ever returned %v across 50 tries, want both peers represented", seen)
+    }
+}
+
+func TestRandomSelectorExcludesSelf(t *testing.T) {
+    reg := &StaticRegistry{}
+    reg.Register("self:1")
+    reg.Register("peer:2")
+    selector := NewRandomSelector(reg, "self:1")
+
+    for i := 0; i < 20; i++ {
+        addr, err := selector.Select()
+        if err != nil {
+            t.Fatalf("Select() error = %v", err)
+        }
+        if addr == "self:1" {
+            t.Fatalf("Select() returned the excluded self address")
+        }
+    }
+}
+
+func TestRandomSelectorNoPeersAvailable(t *testing.T) {
+    reg := &StaticRegistry{}
+    reg.Register("self:1")
+    selector := NewRandomSelector(reg, "self:1")
+
+    if _, err := selector.Select(); err == nil {
+        t.Error("Select() error = nil, want error when only self is registered")
+    }
+}
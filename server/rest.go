@@ -0,0 +1,125 @@
+package server
+
+import (
+    "context"
+    "encoding/json"
+    "net/http"
+    "strconv"
+)
+
+// Logger is the subset of structured logging this package needs. It's
+// satisfied by package main's Logger without an adapter.
+type Logger interface {
+    Error(msg string, kv ...interface{})
+}
+
+// Gateway exposes Ask/GenerateImage/AnalyzeData over REST and gRPC so a
+// `serve` instance can be called directly by peers instead of through the
+// REPL. It's handed plain functions rather than a client type to avoid an
+// import cycle with package main. Each operation takes a context carrying
+// the request's forwarding depth (see ContextWithHops), so an
+// implementation that forwards to a peer on local failure knows when to
+// stop instead of bouncing the request around the mesh forever.
+type Gateway struct {
+    Ask     func(ctx context.Context, question string) (string, error)
+    Image   func(ctx context.Context, prompt string) (string, error)
+    Analyze func(ctx context.Context, data string) (map[string]float64, error)
+    logger  Logger
+}
+
+// NewGateway builds a Gateway from the three operations it fronts.
+func NewGateway(ask func(context.Context, string) (string, error), image func(context.Context, string) (string, error), analyze func(context.Context, string) (map[string]float64, error), logger Logger) *Gateway {
+    return &Gateway{Ask: ask, Image: image, Analyze: analyze, logger: logger}
+}
+
+// requestContext returns r's context annotated with the forwarding depth
+// reported by the incoming X-Grok-Hops header (0 if absent or invalid).
+func requestContext(r *http.Request) context.Context {
+    hops, err := strconv.Atoi(r.Header.Get(HopsHeader))
+    if err != nil || hops < 0 {
+        hops = 0
+    }
+    return ContextWithHops(r.Context(), hops)
+}
+
+// Handler returns the REST API's http.Handler, routing POST /v1/ask,
+// /v1/image, and /v1/analyze to the gateway's operations.
+func (g *Gateway) Handler() http.Handler {
+    mux := http.NewServeMux()
+    mux.HandleFunc("/v1/ask", g.handleAsk)
+    mux.HandleFunc("/v1/image", g.handleImage)
+    mux.HandleFunc("/v1/analyze", g.handleAnalyze)
+    return mux
+}
+
+// ServeREST starts the REST gateway on addr and blocks until it exits.
+func (g *Gateway) ServeREST(addr string) error {
+    return http.ListenAndServe(addr, g.Handler())
+}
+
+func (g *Gateway) handleAsk(w http.ResponseWriter, r *http.Request) {
+    if r.Method != http.MethodPost {
+        http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+        return
+    }
+    var req struct {
+        Question string `json:"question"`
+    }
+    if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+        http.Error(w, "invalid request body", http.StatusBadRequest)
+        return
+    }
+    answer, err := g.Ask(requestContext(r), req.Question)
+    if err != nil {
+        http.Error(w, err.Error(), http.StatusBadGateway)
+        return
+    }
+    g.writeJSON(w, map[string]string{"answer": answer})
+}
+
+func (g *Gateway) handleImage(w http.ResponseWriter, r *http.Request) {
+    if r.Method != http.MethodPost {
+        http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+        return
+    }
+    var req struct {
+        Prompt string `json:"prompt"`
+    }
+    if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+        http.Error(w, "invalid request body", http.StatusBadRequest)
+        return
+    }
+    url, err := g.Image(requestContext(r), req.Prompt)
+    if err != nil {
+        http.Error(w, err.Error(), http.StatusBadGateway)
+        return
+    }
+    g.writeJSON(w, map[string]string{"image_url": url})
+}
+
+func (g *Gateway) handleAnalyze(w http.ResponseWriter, r *http.Request) {
+    if r.Method != http.MethodPost {
+        http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+        return
+    }
+    var req struct {
+        Data string `json:"data"`
+    }
+    if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+        http.Error(w, "invalid request body", http.StatusBadRequest)
+        return
+    }
+    results, err := g.Analyze(requestContext(r), req.Data)
+    if err != nil {
+        http.Error(w, err.Error(), http.StatusBadGateway)
+        return
+    }
+    g.writeJSON(w, results)
+}
+
+func (g *Gateway) writeJSON(w http.ResponseWriter, v interface{}) {
+    w.Header().Set("Content-Type", "application/json")
+    if err := json.NewEncoder(w).Encode(v); err != nil {
+        g.logger.Error("encoding response", "error", err)
+    }
+}
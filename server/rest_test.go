@@ -0,0 +1,138 @@
+package server
+
+import (
+    "bytes"
+    "context"
+    "encoding/json"
+    "errors"
+    "net/http"
+    "net/http/httptest"
+    "testing"
+)
+
+type testLogger struct{}
+
+func (testLogger) Error(msg string, kv ...interface{}) {}
+
+func newTestGateway(ask func(context.Context, string) (string, error)) *Gateway {
+    return NewGateway(
+        ask,
+        func(context.Context, string) (string, error) { return "", errors.New("not used") },
+        func(context.Context, string) (map[string]float64, error) { return nil, errors.New("not used") },
+        testLogger{},
+    )
+}
+
+func TestHandleAskSuccess(t *testing.T) {
+    gw := newTestGateway(func(ctx context.Context, question string) (string, error) {
+        if question != "hello" {
+            t.Errorf("question = %q, want %q", question, "hello")
+        }
+        return "world", nil
+    })
+
+    body, _ := json.Marshal(map[string]string{"question": "hello"})
+    req := httptest.NewRequest(http.MethodPost, "/v1/ask", bytes.NewReader(body))
+    rec := httptest.NewRecorder()
+    gw.Handler().ServeHTTP(rec, req)
+
+    if rec.Code != http.StatusOK {
+        t.Fatalf("status = %d, want 200, body=%s", rec.Code, rec.Body.String())
+    }
+    var resp struct {
+        Answer string `json:"answer"`
+    }
+    if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+        t.Fatalf("decoding response: %v", err)
+    }
+    if resp.Answer != "world" {
+        t.Errorf("answer = %q, want %q", resp.Answer, "world")
+    }
+}
+
+func TestHandleAskRejectsNonPOST(t *testing.T) {
+    gw := newTestGateway(func(context.Context, string) (string, error) { return "", nil })
+
+    req := httptest.NewRequest(http.MethodGet, "/v1/ask", nil)
+    rec := httptest.NewRecorder()
+    gw.Handler().ServeHTTP(rec, req)
+
+    if rec.Code != http.StatusMethodNotAllowed {
+        t.Errorf("status = %d, want 405", rec.Code)
+    }
+}
+
+func TestHandleAskRejectsInvalidBody(t *testing.T) {
+    gw := newTestGateway(func(context.Context, string) (string, error) { return "", nil })
+
+    req := httptest.NewRequest(http.MethodPost, "/v1/ask", bytes.NewReader([]byte("not json")))
+    rec := httptest.NewRecorder()
+    gw.Handler().ServeHTTP(rec, req)
+
+    if rec.Code != http.StatusBadRequest {
+        t.Errorf("status = %d, want 400", rec.Code)
+    }
+}
+
+func TestHandleAskUpstreamErrorReturnsBadGateway(t *testing.T) {
+    gw := newTestGateway(func(context.Context, string) (string, error) {
+        return "", errors.New("backend unreachable")
+    })
+
+    body, _ := json.Marshal(map[string]string{"question": "hello"})
+    req := httptest.NewRequest(http.MethodPost, "/v1/ask", bytes.NewReader(body))
+    rec := httptest.NewRecorder()
+    gw.Handler().ServeHTTP(rec, req)
+
+    if rec.Code != http.StatusBadGateway {
+        t.Errorf("status = %d, want 502", rec.Code)
+    }
+}
+
+func TestHandleAskPropagatesHopsFromHeader(t *testing.T) {
+    var gotHops int
+    gw := newTestGateway(func(ctx context.Context, question string) (string, error) {
+        gotHops = HopsFromContext(ctx)
+        return "ok", nil
+    })
+
+    body, _ := json.Marshal(map[string]string{"question": "hello"})
+    req := httptest.NewRequest(http.MethodPost, "/v1/ask", bytes.NewReader(body))
+    req.Header.Set(HopsHeader, "1")
+    rec := httptest.NewRecorder()
+    gw.Handler().ServeHTTP(rec, req)
+
+    if rec.Code != http.StatusOK {
+        t.Fatalf("status = %d, want 200", rec.Code)
+    }
+    if gotHops != 1 {
+        t.Errorf("hops = %d, want 1", gotHops)
+    }
+}
+
+func TestHandleImageAndAnalyze(t *testing.T) {
+    gw := NewGateway(
+        func(context.Context, string) (string, error) { return "", errors.New("not used") },
+        func(ctx context.Context, prompt string) (string, error) { return "https://example.test/img.png", nil },
+        func(ctx context.Context, data string) (map[string]float64, error) {
+            return map[string]float64{"mean": 2}, nil
+        },
+        testLogger{},
+    )
+
+    imgBody, _ := json.Marshal(map[string]string{"prompt": "a cat"})
+    req := httptest.NewRequest(http.MethodPost, "/v1/image", bytes.NewReader(imgBody))
+    rec := httptest.NewRecorder()
+    gw.Handler().ServeHTTP(rec, req)
+    if rec.Code != http.StatusOK {
+        t.Fatalf("image status = %d, want 200", rec.Code)
+    }
+
+    analyzeBody, _ := json.Marshal(map[string]string{"data": "1,2,3"})
+    req = httptest.NewRequest(http.MethodPost, "/v1/analyze", bytes.NewReader(analyzeBody))
+    rec = httptest.NewRecorder()
+    gw.Handler().ServeHTTP(rec, req)
+    if rec.Code != http.StatusOK {
+        t.Fatalf("analyze status = %d, want 200", rec.Code)
+    }
+}